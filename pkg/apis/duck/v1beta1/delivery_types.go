@@ -0,0 +1,58 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+)
+
+// DeliverySpec contains the delivery options for event senders,
+// such as channels and brokers.
+type DeliverySpec struct {
+	// DeadLetterSink is the sink receiving event that could not be sent to
+	// a destination.
+	// +optional
+	DeadLetterSink *duckv1.Destination `json:"deadLetterSink,omitempty"`
+
+	// Retry is the minimum number of retries the sender should attempt when
+	// sending an event before moving it to the dead letter sink.
+	// +optional
+	Retry *int32 `json:"retry,omitempty"`
+
+	// BackoffPolicy is the retry backoff policy (linear, exponential).
+	// +optional
+	BackoffPolicy *BackoffPolicyType `json:"backoffPolicy,omitempty"`
+
+	// BackoffDelay is the delay before retrying, as an ISO 8601 duration
+	// string (e.g. "PT0.2S" or "PT1H30M"). The backoff policy determines
+	// how this value is used for subsequent retries.
+	// +optional
+	BackoffDelay *string `json:"backoffDelay,omitempty"`
+}
+
+// BackoffPolicyType is the type for backoff policies.
+type BackoffPolicyType string
+
+const (
+	// BackoffPolicyLinear means the time between retries is linear in the
+	// number of retry attempts.
+	BackoffPolicyLinear BackoffPolicyType = "linear"
+
+	// BackoffPolicyExponential means the time between retries grows
+	// exponentially in the number of retry attempts.
+	BackoffPolicyExponential BackoffPolicyType = "exponential"
+)
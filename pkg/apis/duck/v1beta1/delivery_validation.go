@@ -0,0 +1,111 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"context"
+	"regexp"
+	"strconv"
+	"time"
+
+	"knative.dev/pkg/apis"
+)
+
+const (
+	// maxRetry is the largest number of retries a sender is allowed to
+	// request before giving up and sending to the dead letter sink.
+	maxRetry = int32(100)
+
+	// minBackoffDelay and maxBackoffDelay bound how long a sender may wait
+	// between retries.
+	minBackoffDelay = 1 * time.Millisecond
+	maxBackoffDelay = 24 * time.Hour
+)
+
+// iso8601DurationPattern matches the ISO 8601 duration subset BackoffDelay
+// accepts: PT[nH][nM][nS], where n may be fractional. Years, months and
+// days never apply to a retry backoff, so they are intentionally not
+// supported.
+var iso8601DurationPattern = regexp.MustCompile(`^PT(?:(\d+(?:\.\d+)?)H)?(?:(\d+(?:\.\d+)?)M)?(?:(\d+(?:\.\d+)?)S)?$`)
+
+// parseBackoffDelay parses an ISO 8601 duration string (e.g. "PT0.2S",
+// "PT1H30M") into a time.Duration.
+func parseBackoffDelay(s string) (time.Duration, error) {
+	match := iso8601DurationPattern.FindStringSubmatch(s)
+	if match == nil || s == "PT" {
+		return 0, strconv.ErrSyntax
+	}
+
+	var total time.Duration
+	for i, unit := range []time.Duration{time.Hour, time.Minute, time.Second} {
+		if match[i+1] == "" {
+			continue
+		}
+		value, err := strconv.ParseFloat(match[i+1], 64)
+		if err != nil {
+			return 0, err
+		}
+		total += time.Duration(value * float64(unit))
+	}
+	return total, nil
+}
+
+// Validate checks that the DeliverySpec is well formed.
+func (d *DeliverySpec) Validate(ctx context.Context) *apis.FieldError {
+	if d == nil {
+		return nil
+	}
+
+	var errs *apis.FieldError
+
+	if d.Retry != nil {
+		if *d.Retry < 0 {
+			errs = errs.Also(apis.ErrInvalidValue(*d.Retry, "retry"))
+		} else if *d.Retry > maxRetry {
+			errs = errs.Also(apis.ErrOutOfBoundsValue(*d.Retry, 0, maxRetry, "retry"))
+		}
+	}
+
+	if d.BackoffPolicy != nil {
+		switch *d.BackoffPolicy {
+		case BackoffPolicyLinear, BackoffPolicyExponential:
+			if d.BackoffDelay == nil || *d.BackoffDelay == "" {
+				errs = errs.Also(apis.ErrMissingField("backoffDelay"))
+			}
+		default:
+			errs = errs.Also(apis.ErrInvalidValue(*d.BackoffPolicy, "backoffPolicy"))
+		}
+	}
+
+	if d.BackoffDelay != nil {
+		if delay, err := parseBackoffDelay(*d.BackoffDelay); err != nil {
+			errs = errs.Also(apis.ErrInvalidValue(*d.BackoffDelay, "backoffDelay"))
+		} else if delay < minBackoffDelay || delay > maxBackoffDelay {
+			errs = errs.Also(apis.ErrOutOfBoundsValue(*d.BackoffDelay, minBackoffDelay.String(), maxBackoffDelay.String(), "backoffDelay"))
+		}
+	}
+
+	if d.DeadLetterSink != nil {
+		if d.DeadLetterSink.Ref != nil && d.DeadLetterSink.URI != nil {
+			errs = errs.Also(apis.ErrMultipleOneOf("ref", "uri").ViaField("deadLetterSink"))
+		} else {
+			errs = errs.Also(d.DeadLetterSink.Validate(ctx).ViaField("deadLetterSink"))
+		}
+	}
+
+	return errs
+}
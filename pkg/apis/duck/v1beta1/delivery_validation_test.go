@@ -0,0 +1,113 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+)
+
+func TestDeliverySpecValidation(t *testing.T) {
+	linear := BackoffPolicyLinear
+	exponential := BackoffPolicyExponential
+	unknown := BackoffPolicyType("unknown")
+	delay := "PT1S"
+	tooLongDelay := "PT9999H"
+	tooShortDelay := "PT0.0000001S"
+	invalidDelay := "invalid time"
+	retry := int32(5)
+	negativeRetry := int32(-1)
+	tooManyRetries := int32(1000)
+
+	tests := []struct {
+		name string
+		spec *DeliverySpec
+		want *apis.FieldError
+	}{{
+		name: "nil spec",
+		spec: nil,
+		want: nil,
+	}, {
+		name: "valid empty",
+		spec: &DeliverySpec{},
+		want: nil,
+	}, {
+		name: "valid retry",
+		spec: &DeliverySpec{Retry: &retry},
+		want: nil,
+	}, {
+		name: "invalid retry, negative",
+		spec: &DeliverySpec{Retry: &negativeRetry},
+		want: apis.ErrInvalidValue(negativeRetry, "retry"),
+	}, {
+		name: "invalid retry, too many",
+		spec: &DeliverySpec{Retry: &tooManyRetries},
+		want: apis.ErrOutOfBoundsValue(tooManyRetries, 0, maxRetry, "retry"),
+	}, {
+		name: "valid backoff policy and delay",
+		spec: &DeliverySpec{BackoffPolicy: &linear, BackoffDelay: &delay},
+		want: nil,
+	}, {
+		name: "invalid backoff policy, unknown",
+		spec: &DeliverySpec{BackoffPolicy: &unknown},
+		want: apis.ErrInvalidValue(unknown, "backoffPolicy"),
+	}, {
+		name: "invalid backoff policy, missing delay",
+		spec: &DeliverySpec{BackoffPolicy: &exponential},
+		want: apis.ErrMissingField("backoffDelay"),
+	}, {
+		name: "invalid backoff delay, unparseable",
+		spec: &DeliverySpec{BackoffDelay: &invalidDelay},
+		want: apis.ErrInvalidValue(invalidDelay, "backoffDelay"),
+	}, {
+		name: "invalid backoff delay, too long",
+		spec: &DeliverySpec{BackoffDelay: &tooLongDelay},
+		want: apis.ErrOutOfBoundsValue(tooLongDelay, minBackoffDelay.String(), maxBackoffDelay.String(), "backoffDelay"),
+	}, {
+		name: "invalid backoff delay, too short",
+		spec: &DeliverySpec{BackoffDelay: &tooShortDelay},
+		want: apis.ErrOutOfBoundsValue(tooShortDelay, minBackoffDelay.String(), maxBackoffDelay.String(), "backoffDelay"),
+	}, {
+		name: "valid dead letter sink, uri",
+		spec: &DeliverySpec{DeadLetterSink: &duckv1.Destination{URI: apis.HTTP("example.com")}},
+		want: nil,
+	}, {
+		name: "invalid dead letter sink, empty",
+		spec: &DeliverySpec{DeadLetterSink: &duckv1.Destination{}},
+		want: apis.ErrGeneric("expected at least one, got none", "ref", "uri").ViaField("deadLetterSink"),
+	}, {
+		name: "invalid dead letter sink, both ref and uri",
+		spec: &DeliverySpec{DeadLetterSink: &duckv1.Destination{
+			Ref: &duckv1.KReference{Kind: "Service", Name: "svc", APIVersion: "v1"},
+			URI: apis.HTTP("example.com"),
+		}},
+		want: apis.ErrMultipleOneOf("ref", "uri").ViaField("deadLetterSink"),
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := test.spec.Validate(context.Background())
+			if diff := cmp.Diff(test.want.Error(), got.Error()); diff != "" {
+				t.Errorf("DeliverySpec.Validate (-want, +got) = %v", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,34 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package eventing contains the API group definitions shared by every
+// eventing.knative.dev API version.
+package eventing
+
+const (
+	// GroupName is the name of the API group used to register types.
+	GroupName = "eventing.knative.dev"
+
+	// BrokerClassAnnotationKey is the annotation key used to specify the
+	// class of broker controller that should reconcile a given Broker.
+	// This annotation is immutable after creation.
+	BrokerClassAnnotationKey = "eventing.knative.dev/broker.class"
+
+	// MTChannelBrokerClassValue is the value used in the BrokerClassAnnotationKey
+	// to indicate the multi-tenant, channel based Broker implementation owns
+	// the reconciliation of that Broker.
+	MTChannelBrokerClassValue = "MTChannelBasedBroker"
+)
@@ -0,0 +1,80 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	eventingduckv1beta1 "knative.dev/eventing/pkg/apis/duck/v1beta1"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+)
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// Broker collects a pool of events that are consumable using Triggers.
+type Broker struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the desired state of the Broker.
+	Spec BrokerSpec `json:"spec,omitempty"`
+
+	// Status represents the current state of the Broker. This data may be
+	// out of date.
+	// +optional
+	Status BrokerStatus `json:"status,omitempty"`
+}
+
+// BrokerSpec defines the desired state of a Broker.
+//
+// v1 carries forward only the KReference form of Config that v1beta1
+// introduced as ConfigRef; the deprecated ObjectReference form is not part
+// of this version.
+type BrokerSpec struct {
+	// Config is a KReference to the configuration that specifies
+	// implementation specific options for this Broker. Its Namespace
+	// defaults to the Broker's own namespace when omitted.
+	// +optional
+	Config *duckv1.KReference `json:"config,omitempty"`
+
+	// Delivery is the delivery specification for events to the Broker.
+	// This configuration is applied to all triggers that do not specify
+	// their own Delivery.
+	// +optional
+	Delivery *eventingduckv1beta1.DeliverySpec `json:"delivery,omitempty"`
+}
+
+// BrokerStatus represents the current state of a Broker.
+type BrokerStatus struct {
+	duckv1.Status `json:",inline"`
+
+	// Address holds the information needed to connect this Broker to a
+	// sink that will accept events.
+	// +optional
+	Address duckv1.Addressable `json:"address,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// BrokerList is a collection of Brokers.
+type BrokerList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []Broker `json:"items"`
+}
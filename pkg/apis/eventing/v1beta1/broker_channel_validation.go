@@ -0,0 +1,129 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"context"
+
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+
+	eventingduckv1beta1 "knative.dev/eventing/pkg/apis/duck/v1beta1"
+)
+
+// ChannelCapabilities describes what the Channelable implementation behind a
+// Broker's Config/ConfigRef advertises, as reported by a ResourceResolver.
+type ChannelCapabilities struct {
+	// SupportsDeadLetterSink is true when the channel implementation
+	// advertises support for spec.delivery.deadLetterSink.
+	SupportsDeadLetterSink bool
+
+	// SupportedBackoffPolicies lists the eventingduckv1beta1.BackoffPolicyType
+	// values the channel implementation honors. A nil slice means "all of
+	// them", matching the behavior when no resolver is configured.
+	SupportedBackoffPolicies []eventingduckv1beta1.BackoffPolicyType
+}
+
+func (c *ChannelCapabilities) supportsBackoffPolicy(p eventingduckv1beta1.BackoffPolicyType) bool {
+	if c.SupportedBackoffPolicies == nil {
+		return true
+	}
+	for _, supported := range c.SupportedBackoffPolicies {
+		if supported == p {
+			return true
+		}
+	}
+	return false
+}
+
+// ResourceResolver resolves a Broker's Config/ConfigRef to the capabilities
+// of the Channelable duck type it is expected to point at. It returns an
+// error when the referenced object does not exist or does not implement
+// Channelable; the error's message is surfaced verbatim on the relevant
+// field.
+type ResourceResolver interface {
+	Resolve(ctx context.Context, ref duckv1.KReference) (*ChannelCapabilities, error)
+}
+
+type resourceResolverKey struct{}
+
+// WithResourceResolver attaches a ResourceResolver to ctx for BrokerSpec.Validate
+// to use. When absent, Validate falls back to its current syntactic checks
+// on Config/ConfigRef, which keeps unit tests and dry-run callers working
+// without a live duck.ResourceInformer.
+func WithResourceResolver(ctx context.Context, r ResourceResolver) context.Context {
+	return context.WithValue(ctx, resourceResolverKey{}, r)
+}
+
+func resourceResolverFrom(ctx context.Context) ResourceResolver {
+	r, _ := ctx.Value(resourceResolverKey{}).(ResourceResolver)
+	return r
+}
+
+// configReference returns the KReference form of whichever of
+// Config/ConfigRef is set, along with the field name it came from, for use
+// in FieldError paths.
+func (bs *BrokerSpec) configReference() (*duckv1.KReference, string) {
+	switch {
+	case bs.ConfigRef != nil:
+		return bs.ConfigRef, "configRef"
+	case bs.Config != nil:
+		return &duckv1.KReference{
+			Kind:       bs.Config.Kind,
+			Namespace:  bs.Config.Namespace,
+			Name:       bs.Config.Name,
+			APIVersion: bs.Config.APIVersion,
+		}, "config"
+	default:
+		return nil, ""
+	}
+}
+
+// validateChannelCompatibility resolves Config/ConfigRef through the
+// ResourceResolver attached to ctx, if any, and checks that the referenced
+// Channelable can support the requested Delivery.
+func (bs *BrokerSpec) validateChannelCompatibility(ctx context.Context) *apis.FieldError {
+	resolver := resourceResolverFrom(ctx)
+	if resolver == nil {
+		return nil
+	}
+
+	ref, fieldPath := bs.configReference()
+	if ref == nil {
+		return nil
+	}
+
+	caps, err := resolver.Resolve(ctx, *ref)
+	if err != nil {
+		return apis.ErrGeneric(err.Error(), fieldPath)
+	}
+
+	if bs.Delivery == nil {
+		return nil
+	}
+
+	var errs *apis.FieldError
+	if bs.Delivery.DeadLetterSink != nil && !caps.SupportsDeadLetterSink {
+		errs = errs.Also(apis.ErrGeneric(
+			"channel implementation referenced by "+fieldPath+" does not support a dead letter sink",
+			"delivery.deadLetterSink"))
+	}
+	if bs.Delivery.BackoffPolicy != nil && !caps.supportsBackoffPolicy(*bs.Delivery.BackoffPolicy) {
+		errs = errs.Also(apis.ErrInvalidValue(*bs.Delivery.BackoffPolicy, "delivery.backoffPolicy"))
+	}
+	return errs
+}
@@ -0,0 +1,118 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+
+	eventingduckv1beta1 "knative.dev/eventing/pkg/apis/duck/v1beta1"
+)
+
+// fakeResourceResolver is a ResourceResolver backed by a static table,
+// keyed by the referenced object's name, for use in tests.
+type fakeResourceResolver map[string]struct {
+	caps *ChannelCapabilities
+	err  error
+}
+
+func (f fakeResourceResolver) Resolve(ctx context.Context, ref duckv1.KReference) (*ChannelCapabilities, error) {
+	entry, ok := f[ref.Name]
+	if !ok {
+		return nil, fmt.Errorf("channel %q not found", ref.Name)
+	}
+	return entry.caps, entry.err
+}
+
+func TestBrokerSpecChannelCompatibility(t *testing.T) {
+	linear := eventingduckv1beta1.BackoffPolicyLinear
+	exponential := eventingduckv1beta1.BackoffPolicyExponential
+	delay := "PT1S"
+
+	resolver := fakeResourceResolver{
+		"dlq-channel":    {caps: &ChannelCapabilities{SupportsDeadLetterSink: true, SupportedBackoffPolicies: []eventingduckv1beta1.BackoffPolicyType{linear, exponential}}},
+		"no-dlq-channel": {caps: &ChannelCapabilities{SupportsDeadLetterSink: false, SupportedBackoffPolicies: []eventingduckv1beta1.BackoffPolicyType{linear}}},
+		"imconfigmap":    {err: fmt.Errorf("kind %q does not implement Channelable", "ConfigMap")},
+	}
+
+	tests := []struct {
+		name string
+		spec BrokerSpec
+		want *apis.FieldError
+	}{{
+		name: "missing channel",
+		spec: BrokerSpec{
+			ConfigRef: &duckv1.KReference{Namespace: "ns", Name: "does-not-exist", Kind: "InMemoryChannel", APIVersion: "messaging.knative.dev/v1"},
+		},
+		want: apis.ErrGeneric(`channel "does-not-exist" not found`, "configRef"),
+	}, {
+		name: "wrong kind",
+		spec: BrokerSpec{
+			ConfigRef: &duckv1.KReference{Namespace: "ns", Name: "imconfigmap", Kind: "ConfigMap", APIVersion: "v1"},
+		},
+		want: apis.ErrGeneric(`kind "ConfigMap" does not implement Channelable`, "configRef"),
+	}, {
+		name: "unsupported dead letter sink",
+		spec: BrokerSpec{
+			ConfigRef: &duckv1.KReference{Namespace: "ns", Name: "no-dlq-channel", Kind: "InMemoryChannel", APIVersion: "messaging.knative.dev/v1"},
+			Delivery:  &eventingduckv1beta1.DeliverySpec{DeadLetterSink: &duckv1.Destination{URI: apis.HTTP("example.com")}},
+		},
+		want: apis.ErrGeneric("channel implementation referenced by configRef does not support a dead letter sink", "delivery.deadLetterSink"),
+	}, {
+		name: "supported dead letter sink and backoff policy",
+		spec: BrokerSpec{
+			ConfigRef: &duckv1.KReference{Namespace: "ns", Name: "dlq-channel", Kind: "InMemoryChannel", APIVersion: "messaging.knative.dev/v1"},
+			Delivery: &eventingduckv1beta1.DeliverySpec{
+				DeadLetterSink: &duckv1.Destination{URI: apis.HTTP("example.com")},
+				BackoffPolicy:  &linear,
+				BackoffDelay:   &delay,
+			},
+		},
+		want: nil,
+	}, {
+		name: "unsupported backoff policy",
+		spec: BrokerSpec{
+			ConfigRef: &duckv1.KReference{Namespace: "ns", Name: "no-dlq-channel", Kind: "InMemoryChannel", APIVersion: "messaging.knative.dev/v1"},
+			Delivery:  &eventingduckv1beta1.DeliverySpec{BackoffPolicy: &exponential, BackoffDelay: &delay},
+		},
+		want: apis.ErrInvalidValue(exponential, "delivery.backoffPolicy"),
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctx := WithResourceResolver(context.Background(), resolver)
+			got := test.spec.validateChannelCompatibility(ctx)
+			if diff := cmp.Diff(test.want.Error(), got.Error()); diff != "" {
+				t.Errorf("BrokerSpec.validateChannelCompatibility (-want, +got) = %v", diff)
+			}
+		})
+	}
+
+	t.Run("no resolver in context degrades to syntactic checks", func(t *testing.T) {
+		spec := BrokerSpec{
+			ConfigRef: &duckv1.KReference{Namespace: "ns", Name: "does-not-exist", Kind: "InMemoryChannel", APIVersion: "messaging.knative.dev/v1"},
+		}
+		if got := spec.validateChannelCompatibility(context.Background()); got != nil {
+			t.Errorf("expected nil without a resolver, got %v", got)
+		}
+	})
+}
@@ -0,0 +1,92 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"context"
+	"sync"
+
+	"knative.dev/pkg/apis"
+
+	"knative.dev/eventing/pkg/apis/eventing"
+)
+
+// ClassValidator is contributed by a broker implementation to enforce rules
+// specific to the eventing.knative.dev/broker.class it reconciles, such as a
+// required Config GVK or a forbidden Delivery combination. It runs from
+// Broker.Validate after the generic, class-agnostic checks.
+type ClassValidator func(ctx context.Context, spec *BrokerSpec) *apis.FieldError
+
+var (
+	classValidatorsMu sync.RWMutex
+	classValidators   = map[string]ClassValidator{}
+)
+
+// RegisterClassValidator associates a ClassValidator with a broker.class
+// annotation value. It is typically called from an init() in the package
+// that owns the corresponding broker implementation.
+func RegisterClassValidator(className string, v ClassValidator) {
+	classValidatorsMu.Lock()
+	defer classValidatorsMu.Unlock()
+	classValidators[className] = v
+}
+
+func lookupClassValidator(className string) ClassValidator {
+	classValidatorsMu.RLock()
+	defer classValidatorsMu.RUnlock()
+	return classValidators[className]
+}
+
+type strictClassValidationKey struct{}
+
+// WithStrictClassValidation marks ctx so that Broker.Validate, on create
+// (apis.WithinCreate), rejects a broker.class annotation with no registered
+// ClassValidator, rather than silently skipping class-specific checks. A
+// missing annotation counts as an unregistered class. Outside a create the
+// check is skipped. Webhooks that know the full set
+// of installed broker implementations should set this; unit tests and
+// other callers that only care about the generic checks should not.
+func WithStrictClassValidation(ctx context.Context) context.Context {
+	return context.WithValue(ctx, strictClassValidationKey{}, struct{}{})
+}
+
+func isStrictClassValidation(ctx context.Context) bool {
+	return ctx.Value(strictClassValidationKey{}) != nil
+}
+
+func init() {
+	RegisterClassValidator(eventing.MTChannelBrokerClassValue, validateMTChannelBasedBrokerClass)
+}
+
+// validateMTChannelBasedBrokerClass is the in-tree ClassValidator for the
+// default, channel based broker implementation: its Config (or ConfigRef)
+// must point at a ConfigMap holding the channel template.
+func validateMTChannelBasedBrokerClass(ctx context.Context, spec *BrokerSpec) *apis.FieldError {
+	switch {
+	case spec.ConfigRef != nil:
+		if spec.ConfigRef.Kind != "ConfigMap" {
+			return apis.ErrInvalidValue(spec.ConfigRef.Kind, "configRef.kind")
+		}
+	case spec.Config != nil:
+		if spec.Config.Kind != "ConfigMap" {
+			return apis.ErrInvalidValue(spec.Config.Kind, "config.kind")
+		}
+	default:
+		return apis.ErrMissingField("config", "configRef")
+	}
+	return nil
+}
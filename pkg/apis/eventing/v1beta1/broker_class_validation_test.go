@@ -0,0 +1,115 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+
+	"knative.dev/eventing/pkg/apis/eventing"
+)
+
+func TestBrokerClassValidation(t *testing.T) {
+	configMapRef := &duckv1.KReference{Namespace: "ns", Name: "config", Kind: "ConfigMap", APIVersion: "v1"}
+	secretRef := &duckv1.KReference{Namespace: "ns", Name: "config", Kind: "Secret", APIVersion: "v1"}
+
+	tests := []struct {
+		name string
+		ctx  context.Context
+		b    Broker
+		want *apis.FieldError
+	}{{
+		name: "no class annotation",
+		ctx:  context.Background(),
+		b:    Broker{},
+		want: nil,
+	}, {
+		name: "MTChannelBasedBroker, valid ConfigMap config",
+		ctx:  context.Background(),
+		b: Broker{
+			ObjectMeta: metav1.ObjectMeta{
+				Annotations: map[string]string{eventing.BrokerClassAnnotationKey: eventing.MTChannelBrokerClassValue},
+			},
+			Spec: BrokerSpec{
+				ConfigRef: configMapRef,
+			},
+		},
+		want: nil,
+	}, {
+		name: "MTChannelBasedBroker, missing config",
+		ctx:  context.Background(),
+		b: Broker{
+			ObjectMeta: metav1.ObjectMeta{
+				Annotations: map[string]string{eventing.BrokerClassAnnotationKey: eventing.MTChannelBrokerClassValue},
+			},
+		},
+		want: apis.ErrMissingField("spec.config", "spec.configRef"),
+	}, {
+		name: "MTChannelBasedBroker, wrong kind",
+		ctx:  context.Background(),
+		b: Broker{
+			ObjectMeta: metav1.ObjectMeta{
+				Annotations: map[string]string{eventing.BrokerClassAnnotationKey: eventing.MTChannelBrokerClassValue},
+			},
+			Spec: BrokerSpec{
+				ConfigRef: secretRef,
+			},
+		},
+		want: apis.ErrInvalidValue("Secret", "spec.configRef.kind"),
+	}, {
+		name: "unknown class, non-strict mode",
+		ctx:  context.Background(),
+		b: Broker{
+			ObjectMeta: metav1.ObjectMeta{
+				Annotations: map[string]string{eventing.BrokerClassAnnotationKey: "SomeOtherBroker"},
+			},
+		},
+		want: nil,
+	}, {
+		name: "unknown class, strict mode, not a create",
+		ctx:  WithStrictClassValidation(context.Background()),
+		b: Broker{
+			ObjectMeta: metav1.ObjectMeta{
+				Annotations: map[string]string{eventing.BrokerClassAnnotationKey: "SomeOtherBroker"},
+			},
+		},
+		want: nil,
+	}, {
+		name: "unknown class, strict mode, create",
+		ctx:  apis.WithinCreate(WithStrictClassValidation(context.Background())),
+		b: Broker{
+			ObjectMeta: metav1.ObjectMeta{
+				Annotations: map[string]string{eventing.BrokerClassAnnotationKey: "SomeOtherBroker"},
+			},
+		},
+		want: apis.ErrInvalidValue("SomeOtherBroker", "annotations[eventing.knative.dev/broker.class]"),
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := test.b.Validate(test.ctx)
+			if diff := cmp.Diff(test.want.Error(), got.Error()); diff != "" {
+				t.Errorf("Broker.Validate (-want, +got) = %v", diff)
+			}
+		})
+	}
+}
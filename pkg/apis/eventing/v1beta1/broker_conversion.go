@@ -0,0 +1,77 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"context"
+	"fmt"
+
+	v1 "knative.dev/eventing/pkg/apis/eventing/v1"
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+)
+
+// ConvertTo implements apis.Convertible, converting this Broker to v1.
+func (b *Broker) ConvertTo(ctx context.Context, sink apis.Convertible) error {
+	switch sink := sink.(type) {
+	case *v1.Broker:
+		sink.ObjectMeta = b.ObjectMeta
+		sink.Status = v1.BrokerStatus{
+			Status:  b.Status.Status,
+			Address: b.Status.Address,
+		}
+		sink.Spec = v1.BrokerSpec{
+			Delivery: b.Spec.Delivery,
+		}
+
+		switch {
+		case b.Spec.ConfigRef != nil:
+			sink.Spec.Config = b.Spec.ConfigRef
+		case b.Spec.Config != nil:
+			sink.Spec.Config = &duckv1.KReference{
+				Kind:       b.Spec.Config.Kind,
+				Namespace:  b.Spec.Config.Namespace,
+				Name:       b.Spec.Config.Name,
+				APIVersion: b.Spec.Config.APIVersion,
+			}
+		}
+		return nil
+	default:
+		return fmt.Errorf("unknown conversion, got: %T", sink)
+	}
+}
+
+// ConvertFrom implements apis.Convertible, converting a v1 Broker into this
+// version. The round-tripped Config always lands in ConfigRef: v1 never
+// populates the deprecated ObjectReference field.
+func (b *Broker) ConvertFrom(ctx context.Context, source apis.Convertible) error {
+	switch source := source.(type) {
+	case *v1.Broker:
+		b.ObjectMeta = source.ObjectMeta
+		b.Status = BrokerStatus{
+			Status:  source.Status.Status,
+			Address: source.Status.Address,
+		}
+		b.Spec = BrokerSpec{
+			ConfigRef: source.Spec.Config,
+			Delivery:  source.Spec.Delivery,
+		}
+		return nil
+	default:
+		return fmt.Errorf("unknown conversion, got: %T", source)
+	}
+}
@@ -0,0 +1,42 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"context"
+)
+
+// SetDefaults defaults ConfigRef's namespace to the Broker's own namespace
+// when the caller omitted it, mirroring how other duck-typed references
+// (e.g. Subscription's channel) default across this codebase. It also
+// records spec.delivery validity on Status when
+// spec.deliveryEnforcement is DryRun: unlike Validate, a mutating
+// defaulter's changes are persisted, so this is the only place that
+// can actually surface the condition.
+func (b *Broker) SetDefaults(ctx context.Context) {
+	if b.Spec.ConfigRef != nil && b.Spec.ConfigRef.Namespace == "" {
+		b.Spec.ConfigRef.Namespace = b.Namespace
+	}
+
+	if b.Spec.Delivery != nil && b.Spec.deliveryEnforcement() == DeliveryEnforcementDryRun {
+		if deliveryErrs := b.Spec.Delivery.Validate(ctx); deliveryErrs != nil {
+			b.Status.MarkDeliveryInvalid(deliveryErrs.ViaField("spec", "delivery"))
+		} else {
+			b.Status.MarkDeliveryValid()
+		}
+	}
+}
@@ -0,0 +1,107 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	eventingduckv1beta1 "knative.dev/eventing/pkg/apis/duck/v1beta1"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+)
+
+func TestBrokerSetDefaults(t *testing.T) {
+	tests := map[string]struct {
+		b    Broker
+		want *duckv1.KReference
+	}{
+		"no configRef": {
+			b:    Broker{ObjectMeta: metav1.ObjectMeta{Namespace: "ns"}},
+			want: nil,
+		},
+		"configRef namespace omitted": {
+			b: Broker{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "ns"},
+				Spec: BrokerSpec{
+					ConfigRef: &duckv1.KReference{Name: "name", Kind: "kind", APIVersion: "apiversion"},
+				},
+			},
+			want: &duckv1.KReference{Namespace: "ns", Name: "name", Kind: "kind", APIVersion: "apiversion"},
+		},
+		"configRef namespace set": {
+			b: Broker{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "ns"},
+				Spec: BrokerSpec{
+					ConfigRef: &duckv1.KReference{Namespace: "other", Name: "name", Kind: "kind", APIVersion: "apiversion"},
+				},
+			},
+			want: &duckv1.KReference{Namespace: "other", Name: "name", Kind: "kind", APIVersion: "apiversion"},
+		},
+	}
+
+	for n, test := range tests {
+		t.Run(n, func(t *testing.T) {
+			b := test.b
+			b.SetDefaults(context.Background())
+			if diff := cmp.Diff(test.want, b.Spec.ConfigRef); diff != "" {
+				t.Errorf("SetDefaults() ConfigRef (-want, +got) = %v", diff)
+			}
+		})
+	}
+}
+
+func TestBrokerSetDefaultsRecordsDryRunDeliveryValidity(t *testing.T) {
+	dryRun := DeliveryEnforcementDryRun
+	invalidDelay := "invalid time"
+
+	tests := map[string]struct {
+		b        Broker
+		wantTrue bool
+	}{
+		"valid delivery": {
+			b: Broker{
+				Spec: BrokerSpec{
+					DeliveryEnforcement: &dryRun,
+					Delivery:            &eventingduckv1beta1.DeliverySpec{},
+				},
+			},
+			wantTrue: true,
+		},
+		"invalid delivery": {
+			b: Broker{
+				Spec: BrokerSpec{
+					DeliveryEnforcement: &dryRun,
+					Delivery:            &eventingduckv1beta1.DeliverySpec{BackoffDelay: &invalidDelay},
+				},
+			},
+			wantTrue: false,
+		},
+	}
+
+	for n, test := range tests {
+		t.Run(n, func(t *testing.T) {
+			b := test.b
+			b.SetDefaults(context.Background())
+			cond := b.Status.GetCondition(BrokerConditionDeliveryValid)
+			if cond == nil || cond.IsTrue() != test.wantTrue {
+				t.Errorf("expected BrokerConditionDeliveryValid true=%v, got %v", test.wantTrue, cond)
+			}
+		})
+	}
+}
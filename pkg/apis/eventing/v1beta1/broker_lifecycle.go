@@ -0,0 +1,75 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"knative.dev/pkg/apis"
+)
+
+const (
+	// BrokerConditionReady is True when the Broker is ready to accept
+	// events.
+	BrokerConditionReady = apis.ConditionReady
+
+	// BrokerConditionAddressable is True when the Broker has a non-empty
+	// address.
+	BrokerConditionAddressable apis.ConditionType = "Addressable"
+
+	// BrokerConditionDeliveryValid is set only when spec.deliveryEnforcement
+	// is DryRun and spec.delivery is present: True when spec.delivery passed
+	// validation, False, without blocking readiness, when it failed. Under
+	// other enforcement modes the condition is not set.
+	BrokerConditionDeliveryValid apis.ConditionType = "DeliveryValid"
+)
+
+var brokerCondSet = apis.NewLivingConditionSet(
+	BrokerConditionAddressable,
+)
+
+// GetConditionSet retrieves the condition set for this resource.
+func (*Broker) GetConditionSet() apis.ConditionSet {
+	return brokerCondSet
+}
+
+// GetCondition returns the condition currently associated with the given
+// type, or nil.
+func (bs *BrokerStatus) GetCondition(t apis.ConditionType) *apis.Condition {
+	return brokerCondSet.Manage(bs).GetCondition(t)
+}
+
+// InitializeConditions sets relevant unset conditions to Unknown state.
+func (bs *BrokerStatus) InitializeConditions() {
+	brokerCondSet.Manage(bs).InitializeConditions()
+}
+
+// IsReady returns true if the resource is ready overall.
+func (bs *BrokerStatus) IsReady() bool {
+	return brokerCondSet.Manage(bs).IsHappy()
+}
+
+// MarkDeliveryValid marks BrokerConditionDeliveryValid True.
+func (bs *BrokerStatus) MarkDeliveryValid() {
+	brokerCondSet.Manage(bs).MarkTrueWithReason(BrokerConditionDeliveryValid, "DeliveryValid", "")
+}
+
+// MarkDeliveryInvalid marks BrokerConditionDeliveryValid False with err's
+// message. It does not affect Ready: it exists so that a Broker admitted
+// under DeliveryEnforcement: DryRun still surfaces the problem somewhere
+// a caller will see it.
+func (bs *BrokerStatus) MarkDeliveryInvalid(err *apis.FieldError) {
+	brokerCondSet.Manage(bs).MarkFalse(BrokerConditionDeliveryValid, "DeliveryInvalid", err.Error())
+}
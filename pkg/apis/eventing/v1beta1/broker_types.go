@@ -0,0 +1,124 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	eventingduckv1beta1 "knative.dev/eventing/pkg/apis/duck/v1beta1"
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+)
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// Broker collects a pool of events that are consumable using Triggers. Brokers
+// provide a well known endpoint for event delivery that senders can use with
+// minimal knowledge of the event routing strategy. Subscribers use Triggers to
+// request delivery of events from a Broker's pool to a specific URL or
+// Addressable endpoint.
+type Broker struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the desired state of the Broker.
+	Spec BrokerSpec `json:"spec,omitempty"`
+
+	// Status represents the current state of the Broker. This data may be
+	// out of date.
+	// +optional
+	Status BrokerStatus `json:"status,omitempty"`
+}
+
+var (
+	_ apis.Validatable = (*Broker)(nil)
+	_ apis.Defaultable = (*Broker)(nil)
+)
+
+// BrokerSpec defines the desired state of a Broker.
+type BrokerSpec struct {
+	// Config is the reference to the configuration for this Broker, for
+	// example, the config for a channel-backed Broker.
+	//
+	// Deprecated: use ConfigRef instead. Config is kept for backward
+	// compatibility and will be removed in a future release.
+	// +optional
+	Config *corev1.ObjectReference `json:"config,omitempty"`
+
+	// ConfigRef is a KReference to the configuration that specifies
+	// implementation specific options for this Broker, for example, the
+	// config for a channel-backed Broker. Unlike Config, ConfigRef's
+	// Namespace may be omitted, in which case it defaults to the Broker's
+	// own namespace. ConfigRef and Config are mutually exclusive.
+	// +optional
+	ConfigRef *duckv1.KReference `json:"configRef,omitempty"`
+
+	// Delivery is the delivery specification for events to the Broker.
+	// This configuration is applied to all triggers that do not specify
+	// their own Delivery.
+	// +optional
+	Delivery *eventingduckv1beta1.DeliverySpec `json:"delivery,omitempty"`
+
+	// DeliveryEnforcement controls how a validation error in Delivery is
+	// surfaced at admission time. Defaults to Deny when unset.
+	// +optional
+	DeliveryEnforcement *DeliveryEnforcementMode `json:"deliveryEnforcement,omitempty"`
+}
+
+// DeliveryEnforcementMode governs how validation errors in BrokerSpec.Delivery
+// are surfaced by Validate.
+type DeliveryEnforcementMode string
+
+const (
+	// DeliveryEnforcementDeny rejects the request when Delivery is invalid.
+	// This is the default when DeliveryEnforcement is unset.
+	DeliveryEnforcementDeny DeliveryEnforcementMode = "Deny"
+
+	// DeliveryEnforcementWarn admits a request with an invalid Delivery,
+	// surfacing the problem to the caller as a webhook admission warning.
+	DeliveryEnforcementWarn DeliveryEnforcementMode = "Warn"
+
+	// DeliveryEnforcementDryRun admits a request with an invalid Delivery
+	// without any warning, recording the problem on the Broker's status
+	// instead of at admission time.
+	DeliveryEnforcementDryRun DeliveryEnforcementMode = "DryRun"
+)
+
+// BrokerStatus represents the current state of a Broker.
+type BrokerStatus struct {
+	// inherits duck/v1 Status, which currently provides:
+	// * ObservedGeneration
+	// * Conditions
+	duckv1.Status `json:",inline"`
+
+	// Address holds the information needed to connect this Broker to a
+	// sink that will accept events.
+	// +optional
+	Address duckv1.Addressable `json:"address,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// BrokerList is a collection of Brokers.
+type BrokerList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []Broker `json:"items"`
+}
@@ -0,0 +1,155 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+	"knative.dev/pkg/kmp"
+
+	"knative.dev/eventing/pkg/apis/eventing"
+)
+
+// Validate checks Broker for correctness.
+func (b *Broker) Validate(ctx context.Context) *apis.FieldError {
+	errs := b.Spec.Validate(ctx).ViaField("spec")
+
+	class := b.Annotations[eventing.BrokerClassAnnotationKey]
+	if v := lookupClassValidator(class); v != nil {
+		errs = errs.Also(v(ctx, &b.Spec).ViaField("spec"))
+	} else if isStrictClassValidation(ctx) && apis.IsInCreate(ctx) {
+		errs = errs.Also(apis.ErrInvalidValue(class, "annotations["+eventing.BrokerClassAnnotationKey+"]"))
+	}
+
+	return errs
+}
+
+// Validate checks BrokerSpec for correctness.
+func (bs *BrokerSpec) Validate(ctx context.Context) *apis.FieldError {
+	var errs *apis.FieldError
+
+	if bs.Config != nil && bs.ConfigRef != nil {
+		errs = errs.Also(apis.ErrMultipleOneOf("config", "configRef"))
+	} else if bs.ConfigRef != nil {
+		errs = errs.Also(validateConfigRef(bs.ConfigRef).ViaField("configRef"))
+	} else if bs.Config != nil {
+		errs = errs.Also(validateConfig(bs.Config).ViaField("config"))
+	}
+
+	errs = errs.Also(bs.validateChannelCompatibility(ctx))
+
+	switch bs.deliveryEnforcement() {
+	case DeliveryEnforcementDeny, DeliveryEnforcementWarn, DeliveryEnforcementDryRun:
+	default:
+		errs = errs.Also(apis.ErrInvalidValue(*bs.DeliveryEnforcement, "deliveryEnforcement"))
+	}
+
+	if bs.Delivery != nil {
+		if deliveryErrs := bs.Delivery.Validate(ctx).ViaField("delivery"); deliveryErrs != nil {
+			switch bs.deliveryEnforcement() {
+			case DeliveryEnforcementWarn:
+				errs = errs.Also(deliveryErrs.At(apis.WarningLevel))
+			case DeliveryEnforcementDryRun:
+				// Surfaced on the Broker's status by SetDefaults, since
+				// a ValidatingAdmissionWebhook cannot persist status
+				// changes; admission is not blocked.
+			default:
+				errs = errs.Also(deliveryErrs)
+			}
+		}
+	}
+
+	return errs
+}
+
+// deliveryEnforcement returns bs.DeliveryEnforcement, defaulting to Deny
+// when unset.
+func (bs *BrokerSpec) deliveryEnforcement() DeliveryEnforcementMode {
+	if bs.DeliveryEnforcement == nil {
+		return DeliveryEnforcementDeny
+	}
+	return *bs.DeliveryEnforcement
+}
+
+// validateConfig validates the deprecated ObjectReference form of the
+// Broker's config. All four fields are required, matching the contract
+// that has existed since Config was introduced.
+func validateConfig(config *corev1.ObjectReference) *apis.FieldError {
+	var errs *apis.FieldError
+	if config.Name == "" {
+		errs = errs.Also(apis.ErrMissingField("name"))
+	}
+	if config.Namespace == "" {
+		errs = errs.Also(apis.ErrMissingField("namespace"))
+	}
+	if config.Kind == "" {
+		errs = errs.Also(apis.ErrMissingField("kind"))
+	}
+	if config.APIVersion == "" {
+		errs = errs.Also(apis.ErrMissingField("apiVersion"))
+	}
+	return errs
+}
+
+// validateConfigRef validates the KReference form of the Broker's config.
+// Namespace is intentionally not required here: SetDefaults populates it
+// from the Broker's own namespace before Validate ever runs.
+func validateConfigRef(ref *duckv1.KReference) *apis.FieldError {
+	var errs *apis.FieldError
+	if ref.Name == "" {
+		errs = errs.Also(apis.ErrMissingField("name"))
+	}
+	if ref.Kind == "" {
+		errs = errs.Also(apis.ErrMissingField("kind"))
+	}
+	if ref.APIVersion == "" {
+		errs = errs.Also(apis.ErrMissingField("apiVersion"))
+	}
+	return errs
+}
+
+// CheckImmutableFields verifies that the eventing.knative.dev/broker.class
+// annotation, which selects the broker implementation that reconciles this
+// Broker, was not changed after creation.
+func (b *Broker) CheckImmutableFields(ctx context.Context, original *Broker) *apis.FieldError {
+	if original == nil {
+		return nil
+	}
+
+	if diff, err := kmp.ShortDiff(
+		original.Annotations[eventing.BrokerClassAnnotationKey],
+		b.Annotations[eventing.BrokerClassAnnotationKey],
+	); err != nil {
+		return &apis.FieldError{
+			Message: "Failed to diff Broker",
+			Paths:   []string{"annotations"},
+			Details: err.Error(),
+		}
+	} else if diff != "" {
+		return &apis.FieldError{
+			Message: "Immutable fields changed (-old +new)",
+			Paths:   []string{"annotations"},
+			Details: diff,
+		}
+	}
+
+	return nil
+}
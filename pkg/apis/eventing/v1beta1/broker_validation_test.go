@@ -25,6 +25,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	eventingduckv1beta1 "knative.dev/eventing/pkg/apis/duck/v1beta1"
 	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
 )
 
 func TestBrokerImmutableFields(t *testing.T) {
@@ -164,6 +165,66 @@ func TestValidate(t *testing.T) {
 			errs = errs.Also(fe)
 			return errs
 		}(),
+	}, {
+		name: "valid configRef",
+		b: Broker{
+			Spec: BrokerSpec{
+				ConfigRef: &duckv1.KReference{
+					Namespace:  "namespace",
+					Name:       "name",
+					Kind:       "kind",
+					APIVersion: "apiversion",
+				},
+			},
+		},
+		want: nil,
+	}, {
+		name: "valid configRef, namespace omitted",
+		b: Broker{
+			Spec: BrokerSpec{
+				ConfigRef: &duckv1.KReference{
+					Name:       "name",
+					Kind:       "kind",
+					APIVersion: "apiversion",
+				},
+			},
+		},
+		want: nil,
+	}, {
+		name: "invalid, config and configRef both set",
+		b: Broker{
+			Spec: BrokerSpec{
+				Config: &corev1.ObjectReference{
+					Namespace:  "namespace",
+					Name:       "name",
+					Kind:       "kind",
+					APIVersion: "apiversion",
+				},
+				ConfigRef: &duckv1.KReference{
+					Namespace:  "namespace",
+					Name:       "name",
+					Kind:       "kind",
+					APIVersion: "apiversion",
+				},
+			},
+		},
+		want: func() *apis.FieldError {
+			return apis.ErrMultipleOneOf("spec.config", "spec.configRef")
+		}(),
+	}, {
+		name: "invalid configRef, missing kind",
+		b: Broker{
+			Spec: BrokerSpec{
+				ConfigRef: &duckv1.KReference{
+					Namespace:  "namespace",
+					Name:       "name",
+					APIVersion: "apiversion",
+				},
+			},
+		},
+		want: func() *apis.FieldError {
+			return apis.ErrMissingField("spec.configRef.kind")
+		}(),
 	}, {
 		name: "invalid delivery, invalid delay string",
 		b: Broker{
@@ -187,8 +248,76 @@ func TestValidate(t *testing.T) {
 	}
 }
 
+func TestDeliveryEnforcement(t *testing.T) {
+	invalidDelay := "invalid time"
+	deny := DeliveryEnforcementDeny
+	warn := DeliveryEnforcementWarn
+	dryRun := DeliveryEnforcementDryRun
+	unknown := DeliveryEnforcementMode("Ignore")
+
+	tests := []struct {
+		name string
+		b    Broker
+		want *apis.FieldError
+	}{{
+		name: "Deny (default), invalid delivery",
+		b: Broker{
+			Spec: BrokerSpec{
+				Delivery: &eventingduckv1beta1.DeliverySpec{BackoffDelay: &invalidDelay},
+			},
+		},
+		want: apis.ErrInvalidValue(invalidDelay, "spec.delivery.backoffDelay"),
+	}, {
+		name: "Deny, invalid delivery",
+		b: Broker{
+			Spec: BrokerSpec{
+				DeliveryEnforcement: &deny,
+				Delivery:            &eventingduckv1beta1.DeliverySpec{BackoffDelay: &invalidDelay},
+			},
+		},
+		want: apis.ErrInvalidValue(invalidDelay, "spec.delivery.backoffDelay"),
+	}, {
+		name: "Warn, invalid delivery",
+		b: Broker{
+			Spec: BrokerSpec{
+				DeliveryEnforcement: &warn,
+				Delivery:            &eventingduckv1beta1.DeliverySpec{BackoffDelay: &invalidDelay},
+			},
+		},
+		want: apis.ErrInvalidValue(invalidDelay, "spec.delivery.backoffDelay").At(apis.WarningLevel),
+	}, {
+		name: "DryRun, invalid delivery",
+		b: Broker{
+			Spec: BrokerSpec{
+				DeliveryEnforcement: &dryRun,
+				Delivery:            &eventingduckv1beta1.DeliverySpec{BackoffDelay: &invalidDelay},
+			},
+		},
+		want: nil,
+	}, {
+		name: "unknown mode",
+		b: Broker{
+			Spec: BrokerSpec{
+				DeliveryEnforcement: &unknown,
+			},
+		},
+		want: apis.ErrInvalidValue(unknown, "spec.deliveryEnforcement"),
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			b := test.b
+			got := b.Validate(context.Background())
+			if diff := cmp.Diff(test.want.Error(), got.Error()); diff != "" {
+				t.Errorf("Broker.Validate (-want, +got) = %v", diff)
+			}
+		})
+	}
+}
+
 func TestValidSpec(t *testing.T) {
 	bop := eventingduckv1beta1.BackoffPolicyExponential
+	delay := "PT1S"
 	tests := []struct {
 		name string
 		spec BrokerSpec
@@ -217,7 +346,7 @@ func TestValidSpec(t *testing.T) {
 				Kind:       "kind",
 				APIVersion: "apiversion",
 			},
-			Delivery: &eventingduckv1beta1.DeliverySpec{BackoffPolicy: &bop},
+			Delivery: &eventingduckv1beta1.DeliverySpec{BackoffPolicy: &bop, BackoffDelay: &delay},
 		},
 		want: nil,
 	}, {}}